@@ -0,0 +1,88 @@
+// Package bloom implements a 2048-bit, 3-hash bloom filter over receipt logs.
+package bloom
+
+import (
+	"golang.org/x/crypto/sha3"
+)
+
+// bitLength is the size of the filter in bits (2048 bits = 256 bytes),
+// matching go-ethereum's Bloom.
+const bitLength = 2048
+
+// Bloom is a 2048-bit bloom filter over receipt logs.
+type Bloom [bitLength / 8]byte
+
+// New builds a Bloom over entries (receipt log addresses and topics).
+func New(entries ...[]byte) Bloom {
+	var b Bloom
+	for _, e := range entries {
+		b.Add(e)
+	}
+	return b
+}
+
+// MatchesFilter reports whether b could contain a log matching an
+// eth_getLogs-style filter. A false result means it definitely doesn't.
+func (b Bloom) MatchesFilter(addresses [][]byte, topics [][][]byte) bool {
+	if len(addresses) > 0 && !b.testAny(addresses) {
+		return false
+	}
+	for _, alternatives := range topics {
+		if len(alternatives) > 0 && !b.testAny(alternatives) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b Bloom) testAny(entries [][]byte) bool {
+	for _, e := range entries {
+		if b.Test(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add sets the 3 bits derived from Keccak256(data) in b.
+func (b *Bloom) Add(data []byte) {
+	h := keccak256(data)
+	for i := 0; i < 3; i++ {
+		bit := bitIndex(h, i)
+		b[bitLength/8-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test reports whether data's 3 bits are all set in b. A true result means
+// data may be present; a false result means it's definitely absent.
+func (b Bloom) Test(data []byte) bool {
+	h := keccak256(data)
+	for i := 0; i < 3; i++ {
+		bit := bitIndex(h, i)
+		if b[bitLength/8-1-bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Or sets b to the bitwise OR of b and other, combining two blocks' filters.
+func (b *Bloom) Or(other Bloom) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
+
+// bitIndex returns the i'th (of 3) bit position derived from a Keccak256
+// digest: each pair of bytes, taken from the low end, mod 2048.
+func bitIndex(digest [32]byte, i int) uint {
+	return (uint(digest[2*i])<<8 | uint(digest[2*i+1])) & (bitLength - 1)
+}
+
+func keccak256(data []byte) [32]byte {
+	var h [32]byte
+	hw := sha3.NewLegacyKeccak256()
+	hw.Write(data)
+	hw.Sum(h[:0])
+	return h
+}
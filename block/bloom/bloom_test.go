@@ -0,0 +1,72 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func entry(i int) []byte {
+	var b [20]byte
+	binary.BigEndian.PutUint64(b[12:], uint64(i))
+	return b[:]
+}
+
+func TestBloomAddTestOr(t *testing.T) {
+	var b Bloom
+	if b.Test(entry(1)) {
+		t.Fatal("empty bloom must not match anything")
+	}
+
+	b.Add(entry(1))
+	if !b.Test(entry(1)) {
+		t.Fatal("bloom must match an entry that was added")
+	}
+
+	var other Bloom
+	other.Add(entry(2))
+	b.Or(other)
+	if !b.Test(entry(1)) || !b.Test(entry(2)) {
+		t.Fatal("Or must preserve bits from both blooms")
+	}
+}
+
+func TestBloomMatchesFilter(t *testing.T) {
+	b := New(entry(1), entry(2))
+
+	if !b.MatchesFilter([][]byte{entry(1)}, nil) {
+		t.Fatal("must match a present address")
+	}
+	if b.MatchesFilter([][]byte{entry(3)}, nil) {
+		t.Fatal("must not match an absent address")
+	}
+	if !b.MatchesFilter(nil, [][][]byte{{entry(1), entry(99)}}) {
+		t.Fatal("must match if any topic alternative is present")
+	}
+	if b.MatchesFilter(nil, [][][]byte{{entry(1)}, {entry(3)}}) {
+		t.Fatal("every topic position must match for the filter to match")
+	}
+	if !b.MatchesFilter(nil, nil) {
+		t.Fatal("an empty filter must match everything")
+	}
+}
+
+// BenchmarkBloomMatchesFilter simulates eth_getLogs skipping non-matching
+// blocks across a synthetic 100k-block chain.
+func BenchmarkBloomMatchesFilter(b *testing.B) {
+	const chainLength = 100000
+	blooms := make([]Bloom, chainLength)
+	for i := range blooms {
+		blooms[i] = New(entry(i))
+	}
+	filter := [][]byte{entry(chainLength - 1)}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		matched := 0
+		for i := range blooms {
+			if blooms[i].MatchesFilter(filter, nil) {
+				matched++
+			}
+		}
+	}
+}
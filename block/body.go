@@ -0,0 +1,7 @@
+package block
+
+// Body contains the part of a block that sits alongside the header but is
+// not itself committed into it field-by-field — only via merkle roots.
+type Body struct {
+	Operations Operations
+}
@@ -0,0 +1,123 @@
+// Hand-written companion to the Header struct, in the style of gencodec's
+// output. Keep in sync with headerContent whenever its JSON-visible fields
+// change.
+
+package block
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/vechain/thor/acc"
+	"github.com/vechain/thor/bn"
+	"github.com/vechain/thor/cry"
+)
+
+// headerJSON is the JSON wire representation of Header, matching
+// go-ethereum's hexutil conventions (0x-prefixed, minimal digits).
+type headerJSON struct {
+	Version            hexutil.Uint64 `json:"version"`
+	ParentHash         cry.Hash       `json:"parentHash"`
+	Timestamp          hexutil.Uint64 `json:"timestamp"`
+	GasLimit           *hexutil.Big   `json:"gasLimit"`
+	GasUsed            *hexutil.Big   `json:"gasUsed"`
+	Beneficiary        acc.Address    `json:"beneficiary"`
+	TxsRoot            cry.Hash       `json:"txsRoot"`
+	StateRoot          cry.Hash       `json:"stateRoot"`
+	ReceiptsRoot       cry.Hash       `json:"receiptsRoot"`
+	OperationsRoot     cry.Hash       `json:"operationsRoot"`
+	TotalScore         *hexutil.Big   `json:"totalScore"`
+	Signature          hexutil.Bytes  `json:"signature"`
+	Extra              hexutil.Bytes  `json:"extra"`
+	BaseGasPrice       *hexutil.Big   `json:"baseGasPrice"`
+	CommitteeBitmap    hexutil.Bytes  `json:"committeeBitmap"`
+	AggregateSignature hexutil.Bytes  `json:"aggregateSignature"`
+	LogsBloom          hexutil.Bytes  `json:"logsBloom"`
+	Number             hexutil.Uint64 `json:"number"`
+	Hash               cry.Hash       `json:"hash"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *Header) MarshalJSON() ([]byte, error) {
+	var enc headerJSON
+	enc.Version = hexutil.Uint64(h.content.Version)
+	enc.ParentHash = h.content.ParentHash
+	enc.Timestamp = hexutil.Uint64(h.content.Timestamp)
+	enc.GasLimit = (*hexutil.Big)(h.content.GasLimit.ToBig())
+	enc.GasUsed = (*hexutil.Big)(h.content.GasUsed.ToBig())
+	enc.Beneficiary = h.content.Beneficiary
+	enc.TxsRoot = h.content.TxsRoot
+	enc.StateRoot = h.content.StateRoot
+	enc.ReceiptsRoot = h.content.ReceiptsRoot
+	enc.OperationsRoot = h.content.OperationsRoot
+	enc.TotalScore = (*hexutil.Big)(h.content.TotalScore.ToBig())
+	enc.Signature = h.content.Signature
+	enc.Extra = h.content.Extra
+	enc.BaseGasPrice = (*hexutil.Big)(h.content.BaseGasPrice.ToBig())
+	enc.CommitteeBitmap = h.content.CommitteeBitmap
+	enc.AggregateSignature = h.content.AggregateSignature
+	enc.LogsBloom = h.content.LogsBloom[:]
+	enc.Number = hexutil.Uint64(h.Number())
+	enc.Hash = h.Hash()
+	return json.Marshal(&enc)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The resulting Header is
+// rejected via SanityCheck before being accepted, and again if it doesn't
+// hash back to the 'hash' field it was decoded from.
+func (h *Header) UnmarshalJSON(input []byte) error {
+	var dec headerJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.GasLimit == nil {
+		return errors.New("block: missing required field 'gasLimit' for Header")
+	}
+	if dec.GasUsed == nil {
+		return errors.New("block: missing required field 'gasUsed' for Header")
+	}
+	if dec.TotalScore == nil {
+		return errors.New("block: missing required field 'totalScore' for Header")
+	}
+
+	content := headerContent{
+		Version:            uint8(dec.Version),
+		ParentHash:         dec.ParentHash,
+		Timestamp:          uint64(dec.Timestamp),
+		GasLimit:           bn.FromBig((*big.Int)(dec.GasLimit)),
+		GasUsed:            bn.FromBig((*big.Int)(dec.GasUsed)),
+		Beneficiary:        dec.Beneficiary,
+		TxsRoot:            dec.TxsRoot,
+		StateRoot:          dec.StateRoot,
+		ReceiptsRoot:       dec.ReceiptsRoot,
+		OperationsRoot:     dec.OperationsRoot,
+		TotalScore:         bn.FromBig((*big.Int)(dec.TotalScore)),
+		Signature:          append([]byte(nil), dec.Signature...),
+		Extra:              append([]byte(nil), dec.Extra...),
+		CommitteeBitmap:    append([]byte(nil), dec.CommitteeBitmap...),
+		AggregateSignature: append([]byte(nil), dec.AggregateSignature...),
+	}
+	if dec.BaseGasPrice != nil {
+		content.BaseGasPrice = bn.FromBig((*big.Int)(dec.BaseGasPrice))
+	}
+	if len(dec.LogsBloom) > 0 {
+		if len(dec.LogsBloom) != len(content.LogsBloom) {
+			return errors.New("block: invalid length for field 'logsBloom' for Header")
+		}
+		copy(content.LogsBloom[:], dec.LogsBloom)
+	}
+
+	decoded := &Header{content: content}
+	if err := decoded.SanityCheck(); err != nil {
+		return err
+	}
+	// the decoded header must hash back to what it claims, otherwise the
+	// JSON round-trip silently produced a different block.
+	if dec.Hash != decoded.Hash() {
+		return errors.New("block: 'hash' does not match the decoded header's content")
+	}
+	*h = *decoded
+	return nil
+}
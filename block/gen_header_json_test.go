@@ -0,0 +1,64 @@
+package block
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vechain/thor/block/bloom"
+	"github.com/vechain/thor/bn"
+)
+
+func TestHeaderJSONRoundTrip(t *testing.T) {
+	ops := Operations{{Type: OpRewardPayout, Amount: bn.FromUint64(1)}}
+	h, err := (&Header{}).WithOperations(ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h = h.WithExtra([]byte("extra"), bn.FromUint64(42)).
+		WithLogsBloom(bloom.New([]byte("some-address")))
+
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Header
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Hash() != h.Hash() {
+		t.Fatal("header did not round-trip through JSON: hash changed")
+	}
+	if decoded.OperationsRoot() != h.OperationsRoot() {
+		t.Fatal("OperationsRoot did not round-trip through JSON")
+	}
+	if decoded.Version() != h.Version() || string(decoded.Extra()) != string(h.Extra()) {
+		t.Fatal("v1 Extra did not round-trip through JSON")
+	}
+	if decoded.LogsBloom() != h.LogsBloom() {
+		t.Fatal("LogsBloom did not round-trip through JSON")
+	}
+}
+
+func TestHeaderJSONRejectsTamperedHash(t *testing.T) {
+	h := &Header{}
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	raw["hash"] = "0x1122334455667788990011223344556677889900112233445566778899001a2b"
+	tampered, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Header
+	if err := json.Unmarshal(tampered, &decoded); err == nil {
+		t.Fatal("unmarshal must reject a header whose 'hash' field doesn't match its content")
+	}
+}
@@ -3,10 +3,13 @@ package block
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/vechain/thor/acc"
+	"github.com/vechain/thor/block/bloom"
 	"github.com/vechain/thor/bn"
 	"github.com/vechain/thor/cry"
 	"github.com/vechain/thor/dsa"
@@ -23,8 +26,98 @@ type Header struct {
 	}
 }
 
-// headerContent content of header
+// headerContent holds the in-memory fields of a header, for every version.
+// It's never RLP-encoded directly: EncodeRLP/DecodeRLP dispatch on Version
+// to the matching wire struct below.
 type headerContent struct {
+	// Version is the wire layout version this header was built for. It's
+	// stored explicitly (not inferred from which fields are non-zero) so a
+	// v1 header that happens to carry empty Extra/BaseGasPrice still
+	// round-trips as v1.
+	Version uint8
+
+	ParentHash  cry.Hash
+	Timestamp   uint64
+	TotalScore  bn.Int
+	GasLimit    bn.Int
+	GasUsed     bn.Int
+	Beneficiary acc.Address
+
+	TxsRoot      cry.Hash
+	StateRoot    cry.Hash
+	ReceiptsRoot cry.Hash
+
+	Signature []byte
+
+	// OperationsRoot commits to the list of post-execution operations
+	// (e.g. reward payouts, staking unlocks, authority-set updates) applied
+	// by consensus after all txs. It's the zero hash for blocks that carry
+	// no operations.
+	OperationsRoot cry.Hash
+
+	// Extra and BaseGasPrice are v1+ fields, zero-valued on a v0 header.
+	Extra        []byte
+	BaseGasPrice bn.Int
+
+	// CommitteeBitmap marks which members of the authority set contributed
+	// AggregateSignature. It's part of the signed content (included in
+	// HashForSigning) so equivocation - signing two different bitmaps over
+	// the same header - is detectable.
+	CommitteeBitmap []byte
+
+	// AggregateSignature is the committee's aggregated BLS signature, set
+	// alongside CommitteeBitmap. It's kept separate from Signature so a
+	// committee-signed header still carries the proposer's own ECDSA
+	// signature, rather than one overwriting the other.
+	AggregateSignature []byte
+
+	// LogsBloom is a bloom filter over this block's receipt logs (addresses
+	// and topics), letting eth_getLogs-style queries skip blocks that can't
+	// match without reading their receipts.
+	LogsBloom bloom.Bloom
+}
+
+// headerVersion0 is the original, fixed-field header layout, encoded as a
+// plain RLP list exactly as before versioning existed.
+// headerVersion1 adds the Extra and BaseGasPrice fields, encoded as a
+// version byte followed by its own RLP payload.
+const (
+	headerVersion0 = uint8(0)
+	headerVersion1 = uint8(1)
+)
+
+// headerContentV0 is the wire layout of a headerVersion0 header: a plain
+// RLP list, so existing v0 blocks and tools that only understand v0 keep
+// working unchanged. OperationsRoot, CommitteeBitmap, AggregateSignature and
+// LogsBloom were added after v0 shipped, so they're an optional tail on
+// this struct too.
+type headerContentV0 struct {
+	ParentHash  cry.Hash
+	Timestamp   uint64
+	TotalScore  bn.Int
+	GasLimit    bn.Int
+	GasUsed     bn.Int
+	Beneficiary acc.Address
+
+	TxsRoot      cry.Hash
+	StateRoot    cry.Hash
+	ReceiptsRoot cry.Hash
+
+	Signature []byte
+
+	OperationsRoot cry.Hash `rlp:"optional"`
+
+	CommitteeBitmap    []byte      `rlp:"optional"`
+	AggregateSignature []byte      `rlp:"optional"`
+	LogsBloom          bloom.Bloom `rlp:"optional"`
+}
+
+// headerContentV1 is the wire layout of a headerVersion1 header. It's
+// encoded as a single RLP byte string - [version byte][RLP(headerContentV1)]
+// - rather than a bare list, so a v1 header still occupies exactly one RLP
+// item within enclosing structures (block body, chain storage) and decoders
+// can tell it apart from a v0 list by Kind() alone, without a length guess.
+type headerContentV1 struct {
 	ParentHash  cry.Hash
 	Timestamp   uint64
 	TotalScore  bn.Int
@@ -37,6 +130,15 @@ type headerContent struct {
 	ReceiptsRoot cry.Hash
 
 	Signature []byte
+
+	OperationsRoot cry.Hash
+
+	Extra        []byte
+	BaseGasPrice bn.Int
+
+	CommitteeBitmap    []byte      `rlp:"optional"`
+	AggregateSignature []byte      `rlp:"optional"`
+	LogsBloom          bloom.Bloom `rlp:"optional"`
 }
 
 // ParentHash returns hash of parent block.
@@ -94,19 +196,70 @@ func (h *Header) ReceiptsRoot() cry.Hash {
 	return h.content.ReceiptsRoot
 }
 
+// OperationsRoot returns merkle root of the post-execution operations
+// applied after this block's txs. It's the zero hash for blocks that
+// carry no operations.
+func (h *Header) OperationsRoot() cry.Hash {
+	return h.content.OperationsRoot
+}
+
+// Version returns the wire layout version of this header.
+func (h *Header) Version() uint8 {
+	return h.content.Version
+}
+
+// Extra returns the v1 free-form extra data, or nil for a v0 header.
+func (h *Header) Extra() []byte {
+	return append([]byte(nil), h.content.Extra...)
+}
+
+// BaseGasPrice returns the v1 base gas price, or zero for a v0 header.
+func (h *Header) BaseGasPrice() bn.Int {
+	return h.content.BaseGasPrice
+}
+
+// WithOperations creates a new Header with OperationsRoot set to the
+// Merkle root of ops.
+func (h *Header) WithOperations(ops Operations) (*Header, error) {
+	root, err := ops.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	content := h.content
+	content.OperationsRoot = root
+	return &Header{
+		content: content,
+	}, nil
+}
+
+// WithExtra creates a new Header with the v1 Extra and BaseGasPrice fields
+// set, upgrading it to headerVersion1.
+func (h *Header) WithExtra(extra []byte, baseGasPrice bn.Int) *Header {
+	content := h.content
+	content.Version = headerVersion1
+	content.Extra = append([]byte(nil), extra...)
+	content.BaseGasPrice = baseGasPrice
+	return &Header{
+		content: content,
+	}
+}
+
 // Hash computes hash of header (block hash).
 func (h *Header) Hash() cry.Hash {
 	if cached := h.cache.hash; cached != nil {
 		return *cached
 	}
 
-	hw := cry.NewHasher()
+	hw := cry.AcquireHasher()
+	defer cry.ReleaseHasher(hw)
 	rlp.Encode(hw, h)
 
 	var hash cry.Hash
 	hw.Sum(hash[:0])
 
-	// overwrite first 4 bytes of block hash to block number.
+	// overwrite first 4 bytes of block hash to block number. This holds
+	// regardless of header version, so tools that only read the first 4
+	// bytes of the hash don't need to know about v1+ fields.
 	binary.BigEndian.PutUint32(hash[:4], h.Number())
 
 	h.cache.hash = &hash
@@ -115,7 +268,8 @@ func (h *Header) Hash() cry.Hash {
 
 // HashForSigning computes hash of all header fields excluding signature.
 func (h *Header) HashForSigning() cry.Hash {
-	hw := cry.NewHasher()
+	hw := cry.AcquireHasher()
+	defer cry.ReleaseHasher(hw)
 	rlp.Encode(hw, []interface{}{
 		h.content.ParentHash,
 		h.content.Timestamp,
@@ -126,6 +280,11 @@ func (h *Header) HashForSigning() cry.Hash {
 		h.content.TxsRoot,
 		h.content.StateRoot,
 		h.content.ReceiptsRoot,
+		h.content.OperationsRoot,
+		h.content.Extra,
+		h.content.BaseGasPrice,
+		h.content.CommitteeBitmap,
+		h.content.LogsBloom,
 	})
 
 	var hash cry.Hash
@@ -160,20 +319,225 @@ func (h *Header) Signer() (*acc.Address, error) {
 	return &cpy, nil
 }
 
-// EncodeRLP implements rlp.Encoder
+// LogsBloom returns the bloom filter over this block's receipt logs.
+func (h *Header) LogsBloom() bloom.Bloom {
+	return h.content.LogsBloom
+}
+
+// WithLogsBloom creates a new Header with LogsBloom set to b.
+func (h *Header) WithLogsBloom(b bloom.Bloom) *Header {
+	content := h.content
+	content.LogsBloom = b
+	return &Header{
+		content: content,
+	}
+}
+
+// WithAggregateSignature creates a new Header carrying an aggregated BLS
+// committee signature alongside the bitmap recording which committee
+// members contributed it. Unlike the committee signature, Signature (the
+// proposer's own ECDSA signature) is left untouched, so Signer still
+// returns the proposer on a committee-signed header.
+func (h *Header) WithAggregateSignature(sig []byte, bitmap []byte) *Header {
+	content := h.content
+	content.AggregateSignature = append([]byte(nil), sig...)
+	content.CommitteeBitmap = append([]byte(nil), bitmap...)
+	return &Header{
+		content: content,
+	}
+}
+
+// Committee returns the committee members whose aggregated signature covers
+// this header, and the raw aggregate signature bytes. It errors if the
+// header doesn't carry a committee signature.
+func (h *Header) Committee() ([]acc.Address, []byte, error) {
+	if len(h.content.CommitteeBitmap) == 0 {
+		return nil, nil, errors.New("not committee-signed")
+	}
+	verifiers, err := h.Verifiers()
+	if err != nil {
+		return nil, nil, err
+	}
+	return verifiers, h.content.AggregateSignature, nil
+}
+
+// Verifiers recovers the quorum set that co-signed this header's aggregate
+// BLS signature, as opposed to Signer which returns the block proposer.
+func (h *Header) Verifiers() ([]acc.Address, error) {
+	if len(h.content.CommitteeBitmap) == 0 {
+		return nil, errors.New("not committee-signed")
+	}
+	return dsa.VerifyAggregate(h.HashForSigning(), h.content.AggregateSignature, h.content.CommitteeBitmap)
+}
+
+// EncodeRLP implements rlp.Encoder. It dispatches on h.content.Version: a
+// v0 header encodes as a plain RLP list (unchanged from before versioning
+// existed); a v1 header encodes as a single RLP byte string wrapping a
+// version byte and its own payload.
 func (h *Header) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &h.content)
+	c := &h.content
+	switch c.Version {
+	case headerVersion0:
+		return rlp.Encode(w, &headerContentV0{
+			ParentHash:      c.ParentHash,
+			Timestamp:       c.Timestamp,
+			TotalScore:      c.TotalScore,
+			GasLimit:        c.GasLimit,
+			GasUsed:         c.GasUsed,
+			Beneficiary:     c.Beneficiary,
+			TxsRoot:         c.TxsRoot,
+			StateRoot:       c.StateRoot,
+			ReceiptsRoot:    c.ReceiptsRoot,
+			Signature:          c.Signature,
+			OperationsRoot:     c.OperationsRoot,
+			CommitteeBitmap:    c.CommitteeBitmap,
+			AggregateSignature: c.AggregateSignature,
+			LogsBloom:          c.LogsBloom,
+		})
+	case headerVersion1:
+		payload, err := rlp.EncodeToBytes(&headerContentV1{
+			ParentHash:      c.ParentHash,
+			Timestamp:       c.Timestamp,
+			TotalScore:      c.TotalScore,
+			GasLimit:        c.GasLimit,
+			GasUsed:         c.GasUsed,
+			Beneficiary:     c.Beneficiary,
+			TxsRoot:         c.TxsRoot,
+			StateRoot:       c.StateRoot,
+			ReceiptsRoot:    c.ReceiptsRoot,
+			Signature:          c.Signature,
+			OperationsRoot:     c.OperationsRoot,
+			Extra:              c.Extra,
+			BaseGasPrice:       c.BaseGasPrice,
+			CommitteeBitmap:    c.CommitteeBitmap,
+			AggregateSignature: c.AggregateSignature,
+			LogsBloom:          c.LogsBloom,
+		})
+		if err != nil {
+			return err
+		}
+		return rlp.Encode(w, append([]byte{headerVersion1}, payload...))
+	default:
+		return fmt.Errorf("block: unsupported header version %d", c.Version)
+	}
 }
 
-// DecodeRLP implements rlp.Decoder.
+// DecodeRLP implements rlp.Decoder. It tells a v0 header from a v1+ header
+// by peeking the next RLP item's Kind(), without consuming it: v0 is a
+// plain list, v1+ is a byte string whose first byte is the version.
 func (h *Header) DecodeRLP(s *rlp.Stream) error {
+	kind, _, err := s.Kind()
+	if err != nil {
+		return err
+	}
+
 	var content headerContent
+	if kind == rlp.List {
+		var v0 headerContentV0
+		if err := s.Decode(&v0); err != nil {
+			return err
+		}
+		content = headerContent{
+			Version:         headerVersion0,
+			ParentHash:      v0.ParentHash,
+			Timestamp:       v0.Timestamp,
+			TotalScore:      v0.TotalScore,
+			GasLimit:        v0.GasLimit,
+			GasUsed:         v0.GasUsed,
+			Beneficiary:     v0.Beneficiary,
+			TxsRoot:         v0.TxsRoot,
+			StateRoot:       v0.StateRoot,
+			ReceiptsRoot:    v0.ReceiptsRoot,
+			Signature:          v0.Signature,
+			OperationsRoot:     v0.OperationsRoot,
+			CommitteeBitmap:    v0.CommitteeBitmap,
+			AggregateSignature: v0.AggregateSignature,
+			LogsBloom:          v0.LogsBloom,
+		}
+	} else {
+		raw, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		if len(raw) == 0 {
+			return errors.New("block: empty versioned header payload")
+		}
+		version := raw[0]
+		switch version {
+		case headerVersion1:
+			var v1 headerContentV1
+			if err := rlp.DecodeBytes(raw[1:], &v1); err != nil {
+				return err
+			}
+			content = headerContent{
+				Version:         headerVersion1,
+				ParentHash:      v1.ParentHash,
+				Timestamp:       v1.Timestamp,
+				TotalScore:      v1.TotalScore,
+				GasLimit:        v1.GasLimit,
+				GasUsed:         v1.GasUsed,
+				Beneficiary:     v1.Beneficiary,
+				TxsRoot:         v1.TxsRoot,
+				StateRoot:       v1.StateRoot,
+				ReceiptsRoot:    v1.ReceiptsRoot,
+				Signature:          v1.Signature,
+				OperationsRoot:     v1.OperationsRoot,
+				Extra:              v1.Extra,
+				BaseGasPrice:       v1.BaseGasPrice,
+				CommitteeBitmap:    v1.CommitteeBitmap,
+				AggregateSignature: v1.AggregateSignature,
+				LogsBloom:          v1.LogsBloom,
+			}
+		default:
+			return fmt.Errorf("block: unsupported header version %d", version)
+		}
+	}
 
-	if err := s.Decode(&content); err != nil {
+	decoded := Header{content: content}
+	if err := decoded.SanityCheck(); err != nil {
 		return err
 	}
-	*h = Header{
-		content: content,
+	*h = decoded
+	return nil
+}
+
+// maxECDSASignatureLen bounds the proposer's ECDSA Signature.
+// blsSignatureLen is the exact length of a compressed BLS12-381 G1 point,
+// bounding AggregateSignature on a committee-signed header.
+// maxFutureDrift bounds how far a header's Timestamp may sit ahead of now.
+const (
+	maxECDSASignatureLen = 65
+	blsSignatureLen      = 48
+	maxFutureDrift       = 15 * 60 // seconds
+)
+
+// SanityCheck rejects headers with structurally absurd field values -
+// negative amounts, a malformed signature, gas used exceeding gas limit, a
+// timestamp implausibly far in the future - independent of chain context
+// (parent linkage, signer authority, etc. are checked elsewhere). It's
+// called automatically from DecodeRLP, so no malformed header can enter
+// the system undetected.
+func (h *Header) SanityCheck() error {
+	if h.content.TotalScore.Sign() < 0 {
+		return errors.New("block: negative total score")
+	}
+	if h.content.GasLimit.Sign() < 0 {
+		return errors.New("block: negative gas limit")
+	}
+	if h.content.GasUsed.Sign() < 0 {
+		return errors.New("block: negative gas used")
+	}
+	if h.content.GasUsed.Compare(h.content.GasLimit) > 0 {
+		return errors.New("block: gas used exceeds gas limit")
+	}
+	if len(h.content.Signature) > maxECDSASignatureLen {
+		return errors.New("block: signature too long for a single-proposer header")
+	}
+	if len(h.content.CommitteeBitmap) > 0 && len(h.content.AggregateSignature) != blsSignatureLen {
+		return errors.New("block: aggregate signature has the wrong length for a committee-signed header")
+	}
+	if int64(h.content.Timestamp) > time.Now().Unix()+maxFutureDrift {
+		return errors.New("block: timestamp too far in the future")
 	}
 	return nil
 }
@@ -0,0 +1,29 @@
+package block
+
+import "testing"
+
+// BenchmarkHeaderHash exercises Hash() under concurrent load, showing the
+// allocation reduction from pooling hashers via cry.AcquireHasher.
+func BenchmarkHeaderHash(b *testing.B) {
+	h := &Header{}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		// bypass the cache so every iteration actually exercises hashing.
+		local := *h
+		local.cache.hash = nil
+		for pb.Next() {
+			local.cache.hash = nil
+			local.Hash()
+		}
+	})
+}
+
+func BenchmarkHeaderHashForSigning(b *testing.B) {
+	h := &Header{}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			h.HashForSigning()
+		}
+	})
+}
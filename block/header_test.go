@@ -0,0 +1,131 @@
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/bn"
+)
+
+func TestHeaderSanityCheck(t *testing.T) {
+	ok := &Header{content: headerContent{GasLimit: bn.FromUint64(100), GasUsed: bn.FromUint64(50)}}
+	if err := ok.SanityCheck(); err != nil {
+		t.Fatalf("valid header rejected: %v", err)
+	}
+
+	tooMuchGas := &Header{content: headerContent{GasLimit: bn.FromUint64(50), GasUsed: bn.FromUint64(100)}}
+	if err := tooMuchGas.SanityCheck(); err == nil {
+		t.Fatal("header with gasUsed > gasLimit must be rejected")
+	}
+
+	oversizedSig := &Header{content: headerContent{Signature: make([]byte, maxECDSASignatureLen+1)}}
+	if err := oversizedSig.SanityCheck(); err == nil {
+		t.Fatal("oversized single-proposer signature must be rejected")
+	}
+
+	// a committee-signed header still bounds Signature (the proposer's own
+	// ECDSA signature) to maxECDSASignatureLen, and separately bounds
+	// AggregateSignature to the BLS signature length.
+	committeeSig := &Header{content: headerContent{
+		CommitteeBitmap:    []byte{0x01},
+		AggregateSignature: make([]byte, blsSignatureLen),
+	}}
+	if err := committeeSig.SanityCheck(); err != nil {
+		t.Fatalf("committee-signed header with a correctly-sized aggregate signature should be accepted: %v", err)
+	}
+
+	oversizedProposerSigWithCommittee := &Header{content: headerContent{
+		Signature:          make([]byte, maxECDSASignatureLen+1),
+		CommitteeBitmap:    []byte{0x01},
+		AggregateSignature: make([]byte, blsSignatureLen),
+	}}
+	if err := oversizedProposerSigWithCommittee.SanityCheck(); err == nil {
+		t.Fatal("proposer signature must still be bounded on a committee-signed header")
+	}
+
+	wrongLengthAggregateSig := &Header{content: headerContent{
+		CommitteeBitmap:    []byte{0x01},
+		AggregateSignature: make([]byte, blsSignatureLen+1),
+	}}
+	if err := wrongLengthAggregateSig.SanityCheck(); err == nil {
+		t.Fatal("wrong-length aggregate signature must be rejected")
+	}
+
+	futureHeader := &Header{content: headerContent{Timestamp: uint64(time.Now().Unix() + 2*maxFutureDrift)}}
+	if err := futureHeader.SanityCheck(); err == nil {
+		t.Fatal("a timestamp too far in the future must be rejected")
+	}
+}
+
+func TestHeaderDecodeRLPRejectsInsaneHeader(t *testing.T) {
+	bad := &Header{content: headerContent{GasLimit: bn.FromUint64(50), GasUsed: bn.FromUint64(100)}}
+	data, err := rlp.EncodeToBytes(bad)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Header
+	if err := rlp.DecodeBytes(data, &decoded); err == nil {
+		t.Fatal("DecodeRLP must reject a header that fails SanityCheck")
+	}
+}
+
+func TestHeaderVersionMix(t *testing.T) {
+	v0 := &Header{}
+	if v0.Version() != headerVersion0 {
+		t.Fatal("a header with no v1 fields must report version 0")
+	}
+
+	v1 := v0.WithExtra([]byte("foo"), bn.FromUint64(1000))
+	if v1.Version() != headerVersion1 {
+		t.Fatal("a header with Extra/BaseGasPrice set must report version 1")
+	}
+
+	// v0 and v1 headers must both round-trip on the same chain.
+	for _, h := range []*Header{v0, v1} {
+		data, err := rlp.EncodeToBytes(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Header
+		if err := rlp.DecodeBytes(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Version() != h.Version() {
+			t.Fatalf("version did not round-trip: got %d, want %d", decoded.Version(), h.Version())
+		}
+		if decoded.HashForSigning() != h.HashForSigning() {
+			t.Fatal("HashForSigning must round-trip across encode/decode")
+		}
+	}
+
+	// a v1 header's signing hash must differ from the same content at v0,
+	// since it commits to Extra/BaseGasPrice too.
+	if v0.HashForSigning() == v1.HashForSigning() {
+		t.Fatal("v1 fields must be covered by HashForSigning")
+	}
+}
+
+func TestWithAggregateSignaturePreservesProposerSignature(t *testing.T) {
+	proposerSig := []byte{1, 2, 3, 4, 5}
+	aggSig := []byte{6, 7, 8, 9}
+	bitmap := []byte{0x01}
+
+	h := (&Header{}).WithSignature(proposerSig).WithAggregateSignature(aggSig, bitmap)
+
+	if string(h.content.Signature) != string(proposerSig) {
+		t.Fatal("WithAggregateSignature must not overwrite the proposer's own signature")
+	}
+	if string(h.content.AggregateSignature) != string(aggSig) {
+		t.Fatal("WithAggregateSignature must set the aggregate signature")
+	}
+	if _, err := h.Signer(); err != nil {
+		// dsa.Signer rejects these placeholder bytes as not a real ECDSA
+		// signature; what matters here is that it's still trying to recover
+		// from the proposer's signature, not the aggregate one.
+		if string(h.content.Signature) != string(proposerSig) {
+			t.Fatal("Signer must operate on the proposer's signature, not the aggregate one")
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package block
+
+import (
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/acc"
+	"github.com/vechain/thor/bn"
+	"github.com/vechain/thor/cry"
+)
+
+// OperationType identifies the kind of post-execution operation applied by
+// consensus after all transactions in a block have been processed.
+type OperationType uint8
+
+const (
+	// OpRewardPayout pays out a validator's block reward.
+	OpRewardPayout OperationType = iota
+	// OpStakingUnlock releases a previously staked amount back to its owner.
+	OpStakingUnlock
+	// OpAuthorityUpdate adds or removes a member of the authority set.
+	OpAuthorityUpdate
+)
+
+// Operation is a single post-execution state transition, applied by
+// consensus after all txs. It carries no gas, signature or origin.
+type Operation struct {
+	Type   OperationType
+	Target acc.Address
+	Amount bn.Int
+}
+
+// Operations is a list of Operation, in the order they're applied.
+type Operations []*Operation
+
+// RootHash computes the Merkle root committing to the content of ops, so a
+// single operation can later be proven against it without revealing the
+// rest of the list. An empty (or nil) list yields the zero hash.
+func (ops Operations) RootHash() (cry.Hash, error) {
+	if len(ops) == 0 {
+		return cry.Hash{}, nil
+	}
+	level := make([]cry.Hash, len(ops))
+	for i, op := range ops {
+		leaf, err := hashRLP(op)
+		if err != nil {
+			return cry.Hash{}, err
+		}
+		level[i] = leaf
+	}
+	for len(level) > 1 {
+		if len(level)%2 != 0 {
+			level = append(level, level[len(level)-1])
+		}
+		next := make([]cry.Hash, len(level)/2)
+		for i := range next {
+			next[i] = hashPair(level[2*i], level[2*i+1])
+		}
+		level = next
+	}
+	return level[0], nil
+}
+
+// hashRLP returns the Keccak256 hash of the RLP encoding of v.
+func hashRLP(v interface{}) (cry.Hash, error) {
+	hw := cry.AcquireHasher()
+	defer cry.ReleaseHasher(hw)
+	if err := rlp.Encode(hw, v); err != nil {
+		return cry.Hash{}, err
+	}
+	var h cry.Hash
+	hw.Sum(h[:0])
+	return h, nil
+}
+
+// hashPair returns the Keccak256 hash of left and right concatenated,
+// combining two Merkle tree nodes into their parent.
+func hashPair(left, right cry.Hash) cry.Hash {
+	hw := cry.AcquireHasher()
+	defer cry.ReleaseHasher(hw)
+	hw.Write(left[:])
+	hw.Write(right[:])
+	var h cry.Hash
+	hw.Sum(h[:0])
+	return h
+}
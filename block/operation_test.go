@@ -0,0 +1,95 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/acc"
+	"github.com/vechain/thor/bn"
+	"github.com/vechain/thor/cry"
+)
+
+func TestOperationsRootHash(t *testing.T) {
+	if root, err := (Operations(nil)).RootHash(); err != nil || root != (cry.Hash{}) {
+		t.Fatal("nil operations should root-hash to the zero hash")
+	}
+	if root, err := (Operations{}).RootHash(); err != nil || root != (cry.Hash{}) {
+		t.Fatal("empty operations should root-hash to the zero hash")
+	}
+
+	ops := Operations{
+		{Type: OpRewardPayout, Target: acc.Address{1}, Amount: bn.FromUint64(100)},
+		{Type: OpStakingUnlock, Target: acc.Address{2}, Amount: bn.FromUint64(200)},
+	}
+	root, err := ops.RootHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root == (cry.Hash{}) {
+		t.Fatal("non-empty operations must not root-hash to the zero hash")
+	}
+
+	// same content -> same root
+	same := Operations{
+		{Type: OpRewardPayout, Target: acc.Address{1}, Amount: bn.FromUint64(100)},
+		{Type: OpStakingUnlock, Target: acc.Address{2}, Amount: bn.FromUint64(200)},
+	}
+	sameRoot, err := same.RootHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sameRoot != root {
+		t.Fatal("equal operations lists must produce equal roots")
+	}
+
+	// different content -> different root
+	diff := Operations{
+		{Type: OpRewardPayout, Target: acc.Address{1}, Amount: bn.FromUint64(101)},
+	}
+	diffRoot, err := diff.RootHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diffRoot == root {
+		t.Fatal("different operations lists must not collide")
+	}
+}
+
+func TestHeaderOperationsRootRoundTrip(t *testing.T) {
+	ops := Operations{
+		{Type: OpAuthorityUpdate, Target: acc.Address{3}, Amount: bn.Int{}},
+	}
+
+	h, err := (&Header{}).WithOperations(ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := rlp.EncodeToBytes(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Header
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.OperationsRoot() != h.OperationsRoot() {
+		t.Fatal("OperationsRoot did not round-trip through RLP")
+	}
+
+	// a header built with no operations must round-trip to the zero root,
+	// so old blocks keep decoding the same way.
+	empty := &Header{}
+	data, err = rlp.EncodeToBytes(empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decodedEmpty Header
+	if err := rlp.DecodeBytes(data, &decodedEmpty); err != nil {
+		t.Fatal(err)
+	}
+	if decodedEmpty.OperationsRoot() != (cry.Hash{}) {
+		t.Fatal("header with no operations must decode OperationsRoot as zero hash")
+	}
+}
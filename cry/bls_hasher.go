@@ -0,0 +1,10 @@
+package cry
+
+import "golang.org/x/crypto/sha3"
+
+// NewBLSHasher returns a Hasher for deriving the BLS message digest. It is
+// plain Keccak256, the same hash function as NewHasher, with no domain
+// separation between the two.
+func NewBLSHasher() Hasher {
+	return sha3.NewLegacyKeccak256()
+}
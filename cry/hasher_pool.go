@@ -0,0 +1,23 @@
+package cry
+
+import "sync"
+
+// hasherPool recycles Hasher instances so hot paths like block.Header.Hash
+// and HashForSigning don't allocate a fresh Keccak state on every call.
+var hasherPool = sync.Pool{
+	New: func() interface{} {
+		return NewHasher()
+	},
+}
+
+// AcquireHasher returns a Hasher from the pool. Callers must return it via
+// ReleaseHasher once done; the hasher must not be retained afterwards.
+func AcquireHasher() Hasher {
+	return hasherPool.Get().(Hasher)
+}
+
+// ReleaseHasher resets h and returns it to the pool.
+func ReleaseHasher(h Hasher) {
+	h.Reset()
+	hasherPool.Put(h)
+}
@@ -0,0 +1,33 @@
+package cry
+
+import "testing"
+
+func TestAcquireReleaseHasher(t *testing.T) {
+	h := AcquireHasher()
+	h.Write([]byte("hello"))
+	var sum [32]byte
+	h.Sum(sum[:0])
+	ReleaseHasher(h)
+
+	// a reacquired hasher must start from a clean state.
+	h2 := AcquireHasher()
+	var sum2 [32]byte
+	h2.Sum(sum2[:0])
+	ReleaseHasher(h2)
+	if sum2 == sum {
+		t.Fatal("a released hasher must be reset before reuse")
+	}
+}
+
+func BenchmarkAcquireReleaseHasher(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			h := AcquireHasher()
+			h.Write([]byte("hello"))
+			var sum [32]byte
+			h.Sum(sum[:0])
+			ReleaseHasher(h)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package dsa
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/vechain/thor/acc"
+	"github.com/vechain/thor/cry"
+)
+
+// blsMember pairs a committee member's address with its BLS12-381 public
+// key (a compressed G2 point).
+type blsMember struct {
+	addr   acc.Address
+	pubKey []byte
+}
+
+var (
+	blsCommitteeMu sync.RWMutex
+	blsCommittee   []blsMember
+)
+
+// SetCommittee registers the ordered authority set that CommitteeBitmap
+// indexes into: bit i of the bitmap refers to addrs[i]/pubKeys[i].
+// VerifyAggregate looks members up from here, since a BLS aggregate
+// signature alone doesn't reveal who signed.
+func SetCommittee(addrs []acc.Address, pubKeys [][]byte) error {
+	if len(addrs) != len(pubKeys) {
+		return errors.New("dsa: committee addresses/public keys length mismatch")
+	}
+	members := make([]blsMember, len(addrs))
+	for i, addr := range addrs {
+		members[i] = blsMember{addr: addr, pubKey: pubKeys[i]}
+	}
+	blsCommitteeMu.Lock()
+	blsCommittee = members
+	blsCommitteeMu.Unlock()
+	return nil
+}
+
+// VerifyAggregate verifies the BLS aggregate signature sig over hash,
+// contributed by the committee members selected by bitmap, and returns
+// their addresses.
+func VerifyAggregate(hash cry.Hash, sig []byte, bitmap []byte) ([]acc.Address, error) {
+	blsCommitteeMu.RLock()
+	committee := blsCommittee
+	blsCommitteeMu.RUnlock()
+
+	if len(committee) == 0 {
+		return nil, errors.New("dsa: no committee registered")
+	}
+
+	var verifiers []acc.Address
+	var pubKeys [][]byte
+	for i, m := range committee {
+		if i/8 >= len(bitmap) {
+			break
+		}
+		if bitmap[i/8]&(1<<(uint(i)%8)) == 0 {
+			continue
+		}
+		verifiers = append(verifiers, m.addr)
+		pubKeys = append(pubKeys, m.pubKey)
+	}
+	if len(verifiers) == 0 {
+		return nil, errors.New("dsa: bitmap selects no committee member")
+	}
+
+	hw := cry.NewBLSHasher()
+	hw.Write(hash[:])
+	hw.Write(bitmap)
+	var msg cry.Hash
+	hw.Sum(msg[:0])
+
+	if err := verifyBLS12381Aggregate(msg, sig, pubKeys); err != nil {
+		return nil, err
+	}
+	return verifiers, nil
+}
+
+// verifyBLS12381Aggregate checks e(sig, G2Generator) == e(H(msg), aggPubKey)
+// via go-ethereum's bls12381 pairing engine, where aggPubKey is the sum of
+// the selected committee members' G2 public keys.
+func verifyBLS12381Aggregate(msg cry.Hash, sig []byte, pubKeys [][]byte) error {
+	g1 := bls12381.NewG1()
+	g2 := bls12381.NewG2()
+
+	sigPoint, err := g1.FromCompressed(sig)
+	if err != nil {
+		return fmt.Errorf("dsa: invalid aggregate signature: %w", err)
+	}
+
+	aggPubKey := g2.New()
+	for _, pk := range pubKeys {
+		pubPoint, err := g2.FromCompressed(pk)
+		if err != nil {
+			return fmt.Errorf("dsa: invalid committee public key: %w", err)
+		}
+		g2.Add(aggPubKey, aggPubKey, pubPoint)
+	}
+
+	msgPoint, err := messagePoint(g1, msg)
+	if err != nil {
+		return fmt.Errorf("dsa: cannot map message to curve: %w", err)
+	}
+
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(sigPoint, g2.One())
+	engine.AddPairInv(msgPoint, aggPubKey)
+	if !engine.Check() {
+		return errors.New("dsa: BLS aggregate signature verification failed")
+	}
+	return nil
+}
+
+// messagePoint maps msg onto G1. MapToCurve takes a 48-byte field element,
+// so the 32-byte digest is left-padded with zeros.
+func messagePoint(g1 *bls12381.G1, msg cry.Hash) (*bls12381.PointG1, error) {
+	var fe [48]byte
+	copy(fe[16:], msg[:])
+	return g1.MapToCurve(fe[:])
+}
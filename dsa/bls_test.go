@@ -0,0 +1,105 @@
+package dsa
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/vechain/thor/acc"
+	"github.com/vechain/thor/cry"
+)
+
+// blsSign produces a compressed BLS signature over msg with private key sk,
+// mirroring what a committee member would do: sig = sk * H(msg).
+func blsSign(sk *big.Int, msg cry.Hash) []byte {
+	g1 := bls12381.NewG1()
+	h, err := messagePoint(g1, msg)
+	if err != nil {
+		panic(err)
+	}
+	sig := g1.New()
+	g1.MulScalar(sig, h, sk)
+	return g1.ToCompressed(sig)
+}
+
+// blsPubKey derives the compressed G2 public key for private key sk.
+func blsPubKey(sk *big.Int) []byte {
+	g2 := bls12381.NewG2()
+	pub := g2.New()
+	g2.MulScalar(pub, g2.One(), sk)
+	return g2.ToCompressed(pub)
+}
+
+// aggregateMessage reproduces the message VerifyAggregate hashes the
+// committee signature over: Keccak256(hash || bitmap).
+func aggregateMessage(hash cry.Hash, bitmap []byte) cry.Hash {
+	hw := cry.NewBLSHasher()
+	hw.Write(hash[:])
+	hw.Write(bitmap)
+	var msg cry.Hash
+	hw.Sum(msg[:0])
+	return msg
+}
+
+func TestVerifyAggregate(t *testing.T) {
+	sk1 := big.NewInt(12345)
+	sk2 := big.NewInt(67890)
+	addrs := []acc.Address{{1}, {2}}
+	pubKeys := [][]byte{blsPubKey(sk1), blsPubKey(sk2)}
+	if err := SetCommittee(addrs, pubKeys); err != nil {
+		t.Fatal(err)
+	}
+
+	var hash cry.Hash
+	hash[0] = 0xAB
+	bitmap := []byte{0x03} // both members selected
+
+	msg := aggregateMessage(hash, bitmap)
+	sig1 := blsSign(sk1, msg)
+	sig2 := blsSign(sk2, msg)
+	g1 := bls12381.NewG1()
+	aggSig := g1.New()
+	p1, err := g1.FromCompressed(sig1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := g1.FromCompressed(sig2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g1.Add(aggSig, p1, p2)
+	sig := g1.ToCompressed(aggSig)
+
+	verifiers, err := VerifyAggregate(hash, sig, bitmap)
+	if err != nil {
+		t.Fatalf("valid aggregate signature rejected: %v", err)
+	}
+	if len(verifiers) != 2 || verifiers[0] != addrs[0] || verifiers[1] != addrs[1] {
+		t.Fatalf("unexpected verifiers: %v", verifiers)
+	}
+
+	// a tampered signature must be rejected.
+	tamperedSig := append([]byte(nil), sig...)
+	tamperedSig[0] ^= 0xFF
+	if _, err := VerifyAggregate(hash, tamperedSig, bitmap); err == nil {
+		t.Fatal("tampered aggregate signature must be rejected")
+	}
+
+	// a tampered bitmap (dropping a signer without its contribution) must
+	// also be rejected, since the signature no longer matches the claimed
+	// signer set.
+	tamperedBitmap := []byte{0x01}
+	if _, err := VerifyAggregate(hash, sig, tamperedBitmap); err == nil {
+		t.Fatal("aggregate signature must not verify against a mismatched bitmap")
+	}
+}
+
+func TestVerifyAggregateNoCommittee(t *testing.T) {
+	blsCommitteeMu.Lock()
+	blsCommittee = nil
+	blsCommitteeMu.Unlock()
+
+	if _, err := VerifyAggregate(cry.Hash{}, []byte{1, 2, 3}, []byte{0x01}); err == nil {
+		t.Fatal("verification must fail when no committee is registered")
+	}
+}